@@ -0,0 +1,92 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// defaultPidFile backs the --pidfile flag shared by start/stop/reload/status,
+// so a daemon started without an explicit path can still be found by the
+// companion subcommands. defaultLogFile backs start's --logfile flag.
+const (
+	defaultPidFile = "/tmp/daytona-mcp.pid"
+	defaultLogFile = "/tmp/daytona-mcp.log"
+)
+
+// readPID reads and parses the PID written to path by a running daemon.
+func readPID(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("could not read pidfile %s: %w", path, err)
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, fmt.Errorf("invalid pid in pidfile %s: %w", path, err)
+	}
+
+	return pid, nil
+}
+
+// signalDaemon reads the pidfile at path and delivers sig to the process it
+// names, surfacing a clear error if the daemon isn't running.
+func signalDaemon(path string, sig syscall.Signal) error {
+	pid, err := readPID(path)
+	if err != nil {
+		return err
+	}
+
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return fmt.Errorf("could not find process %d: %w", pid, err)
+	}
+
+	if err := process.Signal(sig); err != nil {
+		return fmt.Errorf("could not signal process %d: %w", pid, err)
+	}
+
+	return nil
+}
+
+// isDaemonRunning reports whether the process named by the pidfile at path
+// is alive, by probing it with signal 0.
+func isDaemonRunning(path string) bool {
+	pid, err := readPID(path)
+	if err != nil {
+		return false
+	}
+
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	return process.Signal(syscall.Signal(0)) == nil
+}
+
+// DaemonSignaler is the surface stop/reload/status need to talk to a
+// pidfile-tracked daemon. It exists so tests can substitute a fake and
+// exercise those commands without a real backgrounded process.
+type DaemonSignaler interface {
+	ReadPID(pidfile string) (int, error)
+	IsRunning(pidfile string) bool
+	Signal(pidfile string, sig syscall.Signal) error
+}
+
+// osDaemonSignaler is the default DaemonSignaler, backed by real pidfiles
+// and OS signals.
+type osDaemonSignaler struct{}
+
+func (osDaemonSignaler) ReadPID(pidfile string) (int, error) { return readPID(pidfile) }
+
+func (osDaemonSignaler) IsRunning(pidfile string) bool { return isDaemonRunning(pidfile) }
+
+func (osDaemonSignaler) Signal(pidfile string, sig syscall.Signal) error {
+	return signalDaemon(pidfile, sig)
+}
@@ -0,0 +1,88 @@
+//go:build linux
+
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/sevlyar/go-daemon"
+	"golang.org/x/sys/unix"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// runAsDaemon double-forks the current process via go-daemon, writes its PID
+// to cfg.Pidfile, and points the detached child's log output at a rotating
+// lumberjack sink before handing off to runServer. The foreground process
+// returns as soon as the child is spawned.
+func runAsDaemon(cfg StartConfig) error {
+	daemonCtx := &daemon.Context{
+		PidFileName: cfg.Pidfile,
+		PidFilePerm: 0644,
+		LogFileName: os.DevNull,
+		LogFilePerm: 0640,
+		WorkDir:     "./",
+		Umask:       027,
+	}
+
+	child, err := daemonCtx.Reborn()
+	if err != nil {
+		return fmt.Errorf("could not start daemon: %w", err)
+	}
+
+	if child != nil {
+		fmt.Printf("Daytona MCP server started as daemon (pid %d)\n", child.Pid)
+		return nil
+	}
+	defer daemonCtx.Release()
+
+	logSink := &lumberjack.Logger{
+		Filename:   cfg.Logfile,
+		MaxSize:    100, // megabytes
+		MaxBackups: 5,
+		MaxAge:     28, // days
+		Compress:   true,
+	}
+	defer logSink.Close()
+
+	if err := redirectStdoutStderr(logSink); err != nil {
+		return fmt.Errorf("could not redirect daemon output to %s: %w", cfg.Logfile, err)
+	}
+
+	log.SetOutput(logSink)
+
+	return runServer(cfg)
+}
+
+// redirectStdoutStderr dups the process's stdout and stderr onto a pipe
+// whose read end is copied into sink, so output written directly to those
+// fds (not just through the log package) flows through the same rotating
+// sink instead of go-daemon's raw, unrotated log file. sink is the sole
+// owner of cfg.Logfile; nothing else may hold it open.
+//
+// This uses golang.org/x/sys/unix rather than the standard syscall package:
+// syscall.Dup2 isn't implemented on every Linux GOARCH (notably arm64,
+// riscv64), while unix.Dup2 falls back to dup3 there.
+func redirectStdoutStderr(sink io.Writer) error {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+
+	if err := unix.Dup2(int(w.Fd()), int(os.Stdout.Fd())); err != nil {
+		return err
+	}
+	if err := unix.Dup2(int(w.Fd()), int(os.Stderr.Fd())); err != nil {
+		return err
+	}
+	w.Close()
+
+	go io.Copy(sink, r)
+
+	return nil
+}
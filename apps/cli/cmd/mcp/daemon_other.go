@@ -0,0 +1,16 @@
+//go:build !linux
+
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import "fmt"
+
+// runAsDaemon is a stub on non-Linux platforms: the double-fork and the
+// stdout/stderr redirection it depends on on Linux (see daemon_linux.go)
+// aren't available, so --daemon fails loudly here instead of silently
+// falling back to an unrotated, un-redirected log file.
+func runAsDaemon(cfg StartConfig) error {
+	return fmt.Errorf("--daemon is not supported on this platform")
+}
@@ -0,0 +1,37 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import (
+	"errors"
+	"syscall"
+)
+
+// fakeDaemonSignaler is a DaemonSignaler that never reads a real pidfile or
+// sends a real signal, letting stop/reload/status's running, stale-pidfile,
+// and not-running branches be exercised directly.
+type fakeDaemonSignaler struct {
+	pid        int
+	readPIDErr error
+	running    bool
+	signalErr  error
+
+	signalled []syscall.Signal
+}
+
+func (f *fakeDaemonSignaler) ReadPID(pidfile string) (int, error) {
+	if f.readPIDErr != nil {
+		return 0, f.readPIDErr
+	}
+	return f.pid, nil
+}
+
+func (f *fakeDaemonSignaler) IsRunning(pidfile string) bool { return f.running }
+
+func (f *fakeDaemonSignaler) Signal(pidfile string, sig syscall.Signal) error {
+	f.signalled = append(f.signalled, sig)
+	return f.signalErr
+}
+
+var errUnreadablePidfile = errors.New("could not read pidfile")
@@ -0,0 +1,23 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import "github.com/spf13/cobra"
+
+// NewMCPCmd builds the `daytona mcp` command, registering start/stop/reload/
+// status as its subcommands so the CLI root only needs to AddCommand this
+// one to make the whole tree reachable.
+func NewMCPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mcp",
+		Short: "Manage the Daytona MCP Server",
+	}
+
+	cmd.AddCommand(StartCmd, StopCmd, ReloadCmd, StatusCmd)
+
+	return cmd
+}
+
+// MCPCmd is the `daytona mcp` command used by the real CLI root.
+var MCPCmd = NewMCPCmd()
@@ -0,0 +1,17 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import "testing"
+
+func TestNewMCPCmd_RegistersSubcommands(t *testing.T) {
+	cmd := NewMCPCmd()
+
+	want := []string{"start", "stop", "reload", "status"}
+	for _, use := range want {
+		if c, _, err := cmd.Find([]string{use}); err != nil || c.Name() != use {
+			t.Errorf("mcp %s not registered on MCPCmd", use)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import (
+	"fmt"
+	"syscall"
+
+	"github.com/spf13/cobra"
+)
+
+// ReloadConfig holds everything ReloadCmd needs to run.
+type ReloadConfig struct {
+	Pidfile  string
+	Signaler DaemonSignaler
+}
+
+// NewDefaultReloadConfig returns the ReloadConfig used by the real ReloadCmd.
+func NewDefaultReloadConfig() ReloadConfig {
+	return ReloadConfig{Pidfile: defaultPidFile, Signaler: osDaemonSignaler{}}
+}
+
+// NewReloadCmd builds the `mcp reload` command from cfg.
+func NewReloadCmd(cfg ReloadConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reload",
+		Short: "Reload a daemonized Daytona MCP Server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cfg.Signaler.IsRunning(cfg.Pidfile) {
+				return fmt.Errorf("no running Daytona MCP server found for pidfile %s", cfg.Pidfile)
+			}
+
+			if err := cfg.Signaler.Signal(cfg.Pidfile, syscall.SIGHUP); err != nil {
+				return fmt.Errorf("could not reload daemon: %w", err)
+			}
+
+			fmt.Println("Daytona MCP server reloaded")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&cfg.Pidfile, "pidfile", cfg.Pidfile, "Path to the pidfile written by 'mcp start --daemon'")
+
+	return cmd
+}
+
+var ReloadCmd = NewReloadCmd(NewDefaultReloadConfig())
@@ -0,0 +1,51 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// seccompProfile mirrors the OCI runtime-spec seccomp shape: a default
+// action applied to every syscall not explicitly listed, restricted to a
+// set of architectures, plus per-syscall overrides.
+type seccompProfile struct {
+	DefaultAction string               `json:"defaultAction"`
+	Architectures []string             `json:"architectures"`
+	Syscalls      []seccompSyscallRule `json:"syscalls"`
+}
+
+// seccompSyscallRule grants or denies one or more syscalls by name,
+// optionally only when their arguments match.
+type seccompSyscallRule struct {
+	Names  []string     `json:"names"`
+	Action string       `json:"action"`
+	Args   []seccompArg `json:"args,omitempty"`
+}
+
+// seccompArg conditions a seccompSyscallRule on a single syscall argument,
+// matching libseccomp's comparator scheme (e.g. "SCMP_CMP_EQ").
+type seccompArg struct {
+	Index    uint   `json:"index"`
+	Value    uint64 `json:"value"`
+	ValueTwo uint64 `json:"valueTwo,omitempty"`
+	Op       string `json:"op"`
+}
+
+// loadSeccompProfile reads and parses the seccomp profile at path.
+func loadSeccompProfile(path string) (*seccompProfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read seccomp profile %s: %w", path, err)
+	}
+
+	var profile seccompProfile
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return nil, fmt.Errorf("could not parse seccomp profile %s: %w", path, err)
+	}
+
+	return &profile, nil
+}
@@ -0,0 +1,161 @@
+//go:build linux
+
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import (
+	"fmt"
+	"syscall"
+
+	seccomp "github.com/seccomp/libseccomp-golang"
+)
+
+// applySeccompProfile loads the OCI-style seccomp profile at path and
+// applies it to the current process via libseccomp. It must be called
+// before the server starts serving, since the filter also governs the
+// syscalls the calling goroutine itself is allowed to make.
+func applySeccompProfile(path string) error {
+	profile, err := loadSeccompProfile(path)
+	if err != nil {
+		return err
+	}
+
+	defaultAction, err := seccompActionFromString(profile.DefaultAction)
+	if err != nil {
+		return fmt.Errorf("default action: %w", err)
+	}
+
+	filter, err := seccomp.NewFilter(defaultAction)
+	if err != nil {
+		return fmt.Errorf("could not create seccomp filter: %w", err)
+	}
+	defer filter.Release()
+
+	if err := configureFilterArches(filter, profile.Architectures); err != nil {
+		return err
+	}
+
+	for _, rule := range profile.Syscalls {
+		if err := addSyscallRule(filter, rule); err != nil {
+			return err
+		}
+	}
+
+	if err := filter.Load(); err != nil {
+		return fmt.Errorf("could not load seccomp filter: %w", err)
+	}
+
+	return nil
+}
+
+func configureFilterArches(filter *seccomp.ScmpFilter, architectures []string) error {
+	for _, a := range architectures {
+		arch, err := seccompArchFromString(a)
+		if err != nil {
+			return err
+		}
+
+		if err := filter.AddArch(arch); err != nil {
+			return fmt.Errorf("could not add architecture %s: %w", a, err)
+		}
+	}
+
+	return nil
+}
+
+func addSyscallRule(filter *seccomp.ScmpFilter, rule seccompSyscallRule) error {
+	action, err := seccompActionFromString(rule.Action)
+	if err != nil {
+		return fmt.Errorf("syscall rule %v: %w", rule.Names, err)
+	}
+
+	conditions, err := seccompConditionsFromArgs(rule.Args)
+	if err != nil {
+		return fmt.Errorf("syscall rule %v: %w", rule.Names, err)
+	}
+
+	for _, name := range rule.Names {
+		syscallID, err := seccomp.GetSyscallFromName(name)
+		if err != nil {
+			return fmt.Errorf("unknown syscall %q: %w", name, err)
+		}
+
+		if len(conditions) == 0 {
+			if err := filter.AddRule(syscallID, action); err != nil {
+				return fmt.Errorf("could not add rule for %q: %w", name, err)
+			}
+			continue
+		}
+
+		if err := filter.AddRuleConditional(syscallID, action, conditions); err != nil {
+			return fmt.Errorf("could not add conditional rule for %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func seccompConditionsFromArgs(args []seccompArg) ([]seccomp.ScmpCondition, error) {
+	conditions := make([]seccomp.ScmpCondition, 0, len(args))
+
+	for _, a := range args {
+		op, err := seccompCompareOpFromString(a.Op)
+		if err != nil {
+			return nil, err
+		}
+
+		condition, err := seccomp.MakeCondition(a.Index, op, a.Value, a.ValueTwo)
+		if err != nil {
+			return nil, fmt.Errorf("could not build argument condition: %w", err)
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	return conditions, nil
+}
+
+func seccompActionFromString(action string) (seccomp.ScmpAction, error) {
+	switch action {
+	case "SCMP_ACT_ALLOW":
+		return seccomp.ActAllow, nil
+	case "SCMP_ACT_ERRNO":
+		return seccomp.ActErrno.SetReturnCode(int16(syscall.EPERM)), nil
+	default:
+		return 0, fmt.Errorf("unsupported seccomp action %q", action)
+	}
+}
+
+func seccompArchFromString(arch string) (seccomp.ScmpArch, error) {
+	switch arch {
+	case "amd64", "SCMP_ARCH_X86_64":
+		return seccomp.ArchAMD64, nil
+	case "arm64", "SCMP_ARCH_AARCH64":
+		return seccomp.ArchARM64, nil
+	default:
+		return 0, fmt.Errorf("unsupported seccomp architecture %q", arch)
+	}
+}
+
+func seccompCompareOpFromString(op string) (seccomp.ScmpCompareOp, error) {
+	switch op {
+	case "SCMP_CMP_EQ":
+		return seccomp.CompareEqual, nil
+	case "SCMP_CMP_NE":
+		return seccomp.CompareNotEqual, nil
+	case "SCMP_CMP_LT":
+		return seccomp.CompareLess, nil
+	case "SCMP_CMP_LE":
+		return seccomp.CompareLessOrEqual, nil
+	case "SCMP_CMP_GT":
+		return seccomp.CompareGreater, nil
+	case "SCMP_CMP_GE":
+		return seccomp.CompareGreaterEqual, nil
+	case "SCMP_CMP_MASKED_EQ":
+		return seccomp.CompareMaskedEqual, nil
+	default:
+		return 0, fmt.Errorf("unsupported seccomp argument operator %q", op)
+	}
+}
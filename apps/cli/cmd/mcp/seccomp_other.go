@@ -0,0 +1,15 @@
+//go:build !linux
+
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import "fmt"
+
+// applySeccompProfile is a stub on non-Linux platforms: seccomp is a Linux
+// kernel facility, so --seccomp fails loudly here instead of silently
+// no-op'ing and giving a false sense of sandboxing.
+func applySeccompProfile(path string) error {
+	return fmt.Errorf("--seccomp is not supported on this platform")
+}
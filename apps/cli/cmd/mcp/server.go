@@ -0,0 +1,92 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/daytonaio/daytona-ai-saas/cli/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MCPServer is the surface StartCmd needs from an MCP server implementation.
+// It exists so tests can substitute a fake that never touches a real socket
+// or stdin, instead of depending directly on mark3labs/mcp-go's concrete
+// types.
+type MCPServer interface {
+	ServeStdio() error
+	ServeSSE(ctx context.Context, address, basePath, tlsCert, tlsKey string) error
+	ServeHTTP(ctx context.Context, address, basePath, tlsCert, tlsKey string) error
+}
+
+// ServerFactory builds the MCPServer a StartCmd run should serve. Production
+// code uses newDaytonaMCPServer; tests inject a factory that returns a fake.
+type ServerFactory func() MCPServer
+
+// newDaytonaMCPServer is the default ServerFactory, wrapping the real
+// Daytona MCP server behind the MCPServer interface.
+func newDaytonaMCPServer() MCPServer {
+	s := mcp.NewDaytonaMCPServer()
+	return &daytonaMCPServer{mcpServer: &s.MCPServer}
+}
+
+type daytonaMCPServer struct {
+	mcpServer *server.MCPServer
+}
+
+func (d *daytonaMCPServer) ServeStdio() error {
+	return server.ServeStdio(d.mcpServer)
+}
+
+func (d *daytonaMCPServer) ServeSSE(ctx context.Context, address, basePath, tlsCert, tlsKey string) error {
+	sseServer := server.NewSSEServer(d.mcpServer, server.WithBasePath(basePath))
+	return serveHTTPHandler(ctx, sseServer, address, tlsCert, tlsKey)
+}
+
+func (d *daytonaMCPServer) ServeHTTP(ctx context.Context, address, basePath, tlsCert, tlsKey string) error {
+	httpServer := server.NewStreamableHTTPServer(d.mcpServer, server.WithBasePath(basePath))
+	return serveHTTPHandler(ctx, httpServer, address, tlsCert, tlsKey)
+}
+
+// serveHTTPHandler wraps handler (server.SSEServer or
+// server.StreamableHTTPServer, both of which implement http.Handler) in a
+// stdlib *http.Server, rather than calling transport-specific Start/StartTLS
+// methods: mcp-go's StreamableHTTPServer doesn't expose a StartTLS of its
+// own in every version, so TLS and graceful shutdown are handled uniformly
+// here instead.
+func serveHTTPHandler(ctx context.Context, handler http.Handler, addr, certFile, keyFile string) error {
+	srv := &http.Server{Addr: addr, Handler: handler}
+
+	errChan := make(chan error, 1)
+	go func() {
+		var err error
+		if certFile != "" || keyFile != "" {
+			err = srv.ListenAndServeTLS(certFile, keyFile)
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			errChan <- err
+			return
+		}
+		errChan <- nil
+	}()
+
+	return waitAndShutdown(ctx, errChan, srv.Shutdown)
+}
+
+// waitAndShutdown blocks until either the server errors out or ctx is
+// cancelled by an interrupt/SIGTERM, in which case it calls shutdown with a
+// bounded grace period so in-flight requests can drain before returning.
+func waitAndShutdown(ctx context.Context, errChan chan error, shutdown func(context.Context) error) error {
+	select {
+	case err := <-errChan:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownGracePeriod)
+		defer cancel()
+		return shutdown(shutdownCtx)
+	}
+}
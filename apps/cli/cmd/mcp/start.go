@@ -4,59 +4,146 @@
 package mcp
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
+	"syscall"
+	"time"
 
-	"github.com/daytonaio/daytona-ai-saas/cli/mcp"
-	"github.com/mark3labs/mcp-go/server"
 	"github.com/spf13/cobra"
 )
 
-var StartCmd = &cobra.Command{
-	Use:   "start",
-	Short: "Start Daytona MCP Server",
-	Args:  cobra.NoArgs,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		s := mcp.NewDaytonaMCPServer()
+// shutdownGracePeriod bounds how long a running sse/http server is given to
+// drain in-flight requests after an interrupt before we give up on it.
+const shutdownGracePeriod = 10 * time.Second
 
-		interruptChan := make(chan os.Signal, 1)
-		signal.Notify(interruptChan, os.Interrupt)
+// validTransports is the single source of truth for the transport values
+// accepted by --transport, used both to validate the flag and to render
+// the error message when validation fails.
+var validTransports = []string{"stdio", "sse", "http", "streamable-http"}
 
-		errChan := make(chan error)
+func isValidTransport(t string) bool {
+	for _, v := range validTransports {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+// StartConfig holds everything StartCmd needs to run, replacing the
+// file-level flag globals so the command can be constructed more than once
+// (e.g. with a fake ServerFactory and NotifyContext in tests) without the
+// instances stepping on each other's state.
+type StartConfig struct {
+	Transport string
+	Address   string
+	BasePath  string
+	TLSCert   string
+	TLSKey    string
 
-		if transport == "stdio" {
-			go func() {
-				errChan <- server.ServeStdio(&s.MCPServer)
-			}()
+	Daemon  bool
+	Pidfile string
+	Logfile string
 
-			select {
-			case err := <-errChan:
-				return err
-			case <-interruptChan:
-				return nil
+	// Seccomp is the path to an OCI-style seccomp profile JSON file. When
+	// set, it is applied to the process before the server starts serving.
+	// Linux-only; see seccomp_linux.go and seccomp_other.go.
+	Seccomp string
+
+	// ServerFactory builds the MCP server to serve. Defaults to
+	// newDaytonaMCPServer; tests inject a fake.
+	ServerFactory ServerFactory
+
+	// NotifyContext is signal.NotifyContext by default; tests inject a
+	// fake to drive the shutdown path without sending real OS signals.
+	NotifyContext func(parent context.Context, sig ...os.Signal) (context.Context, context.CancelFunc)
+}
+
+// NewDefaultStartConfig returns the StartConfig used by the real StartCmd.
+func NewDefaultStartConfig() StartConfig {
+	return StartConfig{
+		Transport:     "stdio",
+		Address:       "localhost:3004",
+		Pidfile:       defaultPidFile,
+		Logfile:       defaultLogFile,
+		ServerFactory: newDaytonaMCPServer,
+		NotifyContext: signal.NotifyContext,
+	}
+}
+
+// NewStartCmd builds the `mcp start` command from cfg, binding its flags to
+// cfg's fields so RunE closes over injected config rather than package
+// globals.
+func NewStartCmd(cfg StartConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Start Daytona MCP Server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !isValidTransport(cfg.Transport) {
+				return fmt.Errorf("invalid transport: %s - valid transports are %v", cfg.Transport, validTransports)
 			}
-		} else if transport == "sse" {
-			sseServer := server.NewSSEServer(&s.MCPServer)
-
-			go func() {
-				errChan <- sseServer.Start("localhost:3004")
-			}()
-
-			select {
-			case err := <-errChan:
-				return err
-			case <-interruptChan:
-				return nil
+
+			if cfg.Daemon {
+				return runAsDaemon(cfg)
 			}
-		} else {
-			return fmt.Errorf("invalid transport: %s - valid transports are 'stdio' and 'sse'", transport)
-		}
-	},
+
+			return runServer(cfg)
+		},
+	}
+
+	cmd.Flags().StringVarP(&cfg.Transport, "transport", "t", cfg.Transport, fmt.Sprintf("Transport to use for the server %v", validTransports))
+	cmd.Flags().StringVarP(&cfg.Address, "address", "a", cfg.Address, "Address to bind the sse/http server to")
+	cmd.Flags().StringVar(&cfg.BasePath, "base-path", cfg.BasePath, "Base path to serve the sse/http server under")
+	cmd.Flags().StringVar(&cfg.TLSCert, "tls-cert", cfg.TLSCert, "Path to a TLS certificate file, enables HTTPS for the sse/http server")
+	cmd.Flags().StringVar(&cfg.TLSKey, "tls-key", cfg.TLSKey, "Path to a TLS private key file, enables HTTPS for the sse/http server")
+	cmd.Flags().BoolVarP(&cfg.Daemon, "daemon", "d", cfg.Daemon, "Run the server as a detached background daemon")
+	cmd.Flags().StringVar(&cfg.Pidfile, "pidfile", cfg.Pidfile, "Path to the pidfile written by --daemon")
+	cmd.Flags().StringVar(&cfg.Logfile, "logfile", cfg.Logfile, "Path to the rotating logfile used by --daemon")
+	cmd.Flags().StringVar(&cfg.Seccomp, "seccomp", cfg.Seccomp, "Path to an OCI-style seccomp profile JSON file to sandbox the server with (Linux only)")
+
+	return cmd
 }
 
-var transport string
+var StartCmd = NewStartCmd(NewDefaultStartConfig())
+
+// runAsDaemon is implemented per-platform: see daemon_linux.go for the real
+// go-daemon double-fork and daemon_other.go for the non-Linux stub.
+
+// runServer performs the actual stdio/sse/http server startup shared by both
+// the foreground and daemonized code paths.
+func runServer(cfg StartConfig) error {
+	if cfg.Seccomp != "" {
+		if err := applySeccompProfile(cfg.Seccomp); err != nil {
+			return fmt.Errorf("could not apply seccomp profile: %w", err)
+		}
+	}
+
+	s := cfg.ServerFactory()
+
+	ctx, stop := cfg.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-func init() {
-	StartCmd.Flags().StringVarP(&transport, "transport", "t", "stdio", "Transport to use for the server")
+	switch cfg.Transport {
+	case "stdio":
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- s.ServeStdio()
+		}()
+
+		select {
+		case err := <-errChan:
+			return err
+		case <-ctx.Done():
+			return nil
+		}
+	case "sse":
+		return s.ServeSSE(ctx, cfg.Address, cfg.BasePath, cfg.TLSCert, cfg.TLSKey)
+	case "http", "streamable-http":
+		return s.ServeHTTP(ctx, cfg.Address, cfg.BasePath, cfg.TLSCert, cfg.TLSKey)
+	default:
+		return fmt.Errorf("invalid transport: %s - valid transports are %v", cfg.Transport, validTransports)
+	}
 }
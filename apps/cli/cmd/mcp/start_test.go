@@ -0,0 +1,134 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+)
+
+// fakeMCPServer is an MCPServer that never touches a real socket or stdin,
+// letting runServer's transport dispatch and error propagation be exercised
+// directly.
+type fakeMCPServer struct {
+	stdioBlock chan struct{}
+
+	serveStdioErr error
+	serveSSEErr   error
+	serveHTTPErr  error
+
+	sseCalled  bool
+	httpCalled bool
+}
+
+func (f *fakeMCPServer) ServeStdio() error {
+	if f.stdioBlock != nil {
+		<-f.stdioBlock
+	}
+	return f.serveStdioErr
+}
+
+func (f *fakeMCPServer) ServeSSE(ctx context.Context, address, basePath, tlsCert, tlsKey string) error {
+	f.sseCalled = true
+	return f.serveSSEErr
+}
+
+func (f *fakeMCPServer) ServeHTTP(ctx context.Context, address, basePath, tlsCert, tlsKey string) error {
+	f.httpCalled = true
+	return f.serveHTTPErr
+}
+
+// liveNotifyContext mimics signal.NotifyContext without registering real OS
+// signal handlers.
+func liveNotifyContext(parent context.Context, sig ...os.Signal) (context.Context, context.CancelFunc) {
+	return context.WithCancel(parent)
+}
+
+// cancelledNotifyContext returns a context that is already done, simulating
+// an interrupt having arrived before the server produced a result.
+func cancelledNotifyContext(parent context.Context, sig ...os.Signal) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	cancel()
+	return ctx, cancel
+}
+
+func TestRunServer_TransportDispatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		transport string
+		wantSSE   bool
+		wantHTTP  bool
+	}{
+		{name: "sse", transport: "sse", wantSSE: true},
+		{name: "http", transport: "http", wantHTTP: true},
+		{name: "streamable-http alias", transport: "streamable-http", wantHTTP: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeMCPServer{}
+			cfg := StartConfig{
+				Transport:     tt.transport,
+				ServerFactory: func() MCPServer { return fake },
+				NotifyContext: liveNotifyContext,
+			}
+
+			if err := runServer(cfg); err != nil {
+				t.Fatalf("runServer() error = %v", err)
+			}
+
+			if fake.sseCalled != tt.wantSSE {
+				t.Errorf("ServeSSE called = %v, want %v", fake.sseCalled, tt.wantSSE)
+			}
+			if fake.httpCalled != tt.wantHTTP {
+				t.Errorf("ServeHTTP called = %v, want %v", fake.httpCalled, tt.wantHTTP)
+			}
+		})
+	}
+}
+
+func TestRunServer_InvalidTransport(t *testing.T) {
+	cfg := StartConfig{
+		Transport:     "carrier-pigeon",
+		ServerFactory: func() MCPServer { return &fakeMCPServer{} },
+		NotifyContext: liveNotifyContext,
+	}
+
+	if err := runServer(cfg); err == nil {
+		t.Fatal("runServer() error = nil, want error for invalid transport")
+	}
+}
+
+func TestRunServer_StdioErrorPropagation(t *testing.T) {
+	wantErr := errors.New("boom")
+	fake := &fakeMCPServer{serveStdioErr: wantErr}
+	cfg := StartConfig{
+		Transport:     "stdio",
+		ServerFactory: func() MCPServer { return fake },
+		NotifyContext: liveNotifyContext,
+	}
+
+	if err := runServer(cfg); !errors.Is(err, wantErr) {
+		t.Fatalf("runServer() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestRunServer_StdioShutsDownOnCancelledContext(t *testing.T) {
+	// ServeStdio blocks until stdioBlock is closed, so if runServer returns
+	// at all here it can only be because ctx.Done() won the select.
+	fake := &fakeMCPServer{stdioBlock: make(chan struct{})}
+	defer close(fake.stdioBlock)
+
+	cfg := StartConfig{
+		Transport:     "stdio",
+		ServerFactory: func() MCPServer { return fake },
+		NotifyContext: cancelledNotifyContext,
+	}
+
+	if err := runServer(cfg); err != nil {
+		t.Fatalf("runServer() error = %v, want nil on interrupt", err)
+	}
+}
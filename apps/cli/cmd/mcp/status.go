@@ -0,0 +1,51 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// StatusConfig holds everything StatusCmd needs to run.
+type StatusConfig struct {
+	Pidfile  string
+	Signaler DaemonSignaler
+}
+
+// NewDefaultStatusConfig returns the StatusConfig used by the real StatusCmd.
+func NewDefaultStatusConfig() StatusConfig {
+	return StatusConfig{Pidfile: defaultPidFile, Signaler: osDaemonSignaler{}}
+}
+
+// NewStatusCmd builds the `mcp status` command from cfg.
+func NewStatusCmd(cfg StatusConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the status of a daemonized Daytona MCP Server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pid, err := cfg.Signaler.ReadPID(cfg.Pidfile)
+			if err != nil {
+				fmt.Println("Daytona MCP server is not running")
+				return nil
+			}
+
+			if !cfg.Signaler.IsRunning(cfg.Pidfile) {
+				fmt.Printf("Daytona MCP server is not running (stale pidfile %s)\n", cfg.Pidfile)
+				return nil
+			}
+
+			fmt.Printf("Daytona MCP server is running (pid %d)\n", pid)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&cfg.Pidfile, "pidfile", cfg.Pidfile, "Path to the pidfile written by 'mcp start --daemon'")
+
+	return cmd
+}
+
+var StatusCmd = NewStatusCmd(NewDefaultStatusConfig())
@@ -0,0 +1,86 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+// captureStdout runs fn with os.Stdout redirected to a pipe and returns
+// everything written to it. status.go prints with fmt.Println directly
+// rather than through cmd.OutOrStdout, so this is the only way to observe
+// which of its three branches ran.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() error = %v", err)
+	}
+
+	orig := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = orig }()
+
+	fn()
+
+	w.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("io.ReadAll() error = %v", err)
+	}
+
+	return string(out)
+}
+
+func TestStatusCmd_Running(t *testing.T) {
+	signaler := &fakeDaemonSignaler{running: true, pid: 123}
+	cmd := NewStatusCmd(StatusConfig{Pidfile: "/tmp/daytona-mcp.pid", Signaler: signaler})
+	cmd.SetArgs([]string{})
+
+	var err error
+	out := captureStdout(t, func() { err = cmd.Execute() })
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if !strings.Contains(out, "is running (pid 123)") {
+		t.Fatalf("output = %q, want it to mention the running pid", out)
+	}
+}
+
+func TestStatusCmd_StalePidfile(t *testing.T) {
+	signaler := &fakeDaemonSignaler{pid: 123, running: false}
+	cmd := NewStatusCmd(StatusConfig{Pidfile: "/tmp/daytona-mcp.pid", Signaler: signaler})
+	cmd.SetArgs([]string{})
+
+	var err error
+	out := captureStdout(t, func() { err = cmd.Execute() })
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if !strings.Contains(out, "stale pidfile") {
+		t.Fatalf("output = %q, want it to mention a stale pidfile", out)
+	}
+}
+
+func TestStatusCmd_NotRunning(t *testing.T) {
+	signaler := &fakeDaemonSignaler{readPIDErr: errUnreadablePidfile}
+	cmd := NewStatusCmd(StatusConfig{Pidfile: "/tmp/daytona-mcp.pid", Signaler: signaler})
+	cmd.SetArgs([]string{})
+
+	var err error
+	out := captureStdout(t, func() { err = cmd.Execute() })
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if !strings.Contains(out, "is not running") || strings.Contains(out, "stale pidfile") {
+		t.Fatalf("output = %q, want a plain not-running message", out)
+	}
+}
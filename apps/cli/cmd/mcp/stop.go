@@ -0,0 +1,49 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import (
+	"fmt"
+	"syscall"
+
+	"github.com/spf13/cobra"
+)
+
+// StopConfig holds everything StopCmd needs to run.
+type StopConfig struct {
+	Pidfile  string
+	Signaler DaemonSignaler
+}
+
+// NewDefaultStopConfig returns the StopConfig used by the real StopCmd.
+func NewDefaultStopConfig() StopConfig {
+	return StopConfig{Pidfile: defaultPidFile, Signaler: osDaemonSignaler{}}
+}
+
+// NewStopCmd builds the `mcp stop` command from cfg.
+func NewStopCmd(cfg StopConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop",
+		Short: "Stop a daemonized Daytona MCP Server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cfg.Signaler.IsRunning(cfg.Pidfile) {
+				return fmt.Errorf("no running Daytona MCP server found for pidfile %s", cfg.Pidfile)
+			}
+
+			if err := cfg.Signaler.Signal(cfg.Pidfile, syscall.SIGTERM); err != nil {
+				return fmt.Errorf("could not stop daemon: %w", err)
+			}
+
+			fmt.Println("Daytona MCP server stopped")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&cfg.Pidfile, "pidfile", cfg.Pidfile, "Path to the pidfile written by 'mcp start --daemon'")
+
+	return cmd
+}
+
+var StopCmd = NewStopCmd(NewDefaultStopConfig())
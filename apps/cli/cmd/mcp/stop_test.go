@@ -0,0 +1,49 @@
+// Copyright 2025 Daytona Platforms Inc.
+// SPDX-License-Identifier: AGPL-3.0
+
+package mcp
+
+import (
+	"errors"
+	"syscall"
+	"testing"
+)
+
+func TestStopCmd_Running(t *testing.T) {
+	signaler := &fakeDaemonSignaler{running: true, pid: 123}
+	cmd := NewStopCmd(StopConfig{Pidfile: "/tmp/daytona-mcp.pid", Signaler: signaler})
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if len(signaler.signalled) != 1 || signaler.signalled[0] != syscall.SIGTERM {
+		t.Fatalf("signalled = %v, want [SIGTERM]", signaler.signalled)
+	}
+}
+
+func TestStopCmd_NotRunning(t *testing.T) {
+	signaler := &fakeDaemonSignaler{running: false}
+	cmd := NewStopCmd(StopConfig{Pidfile: "/tmp/daytona-mcp.pid", Signaler: signaler})
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err == nil {
+		t.Fatal("Execute() error = nil, want error for no running daemon")
+	}
+
+	if len(signaler.signalled) != 0 {
+		t.Fatalf("signalled = %v, want none", signaler.signalled)
+	}
+}
+
+func TestStopCmd_SignalError(t *testing.T) {
+	wantErr := errors.New("permission denied")
+	signaler := &fakeDaemonSignaler{running: true, pid: 123, signalErr: wantErr}
+	cmd := NewStopCmd(StopConfig{Pidfile: "/tmp/daytona-mcp.pid", Signaler: signaler})
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err == nil || !errors.Is(err, wantErr) {
+		t.Fatalf("Execute() error = %v, want wrapping %v", err, wantErr)
+	}
+}